@@ -0,0 +1,98 @@
+// Package glossx fills a gap in lipgloss.StyleRunes: that function doesn't handle multi-line text (a styled
+// background bleeds past the end of each line), and it can split a multi-rune grapheme cluster (a combining mark,
+// an emoji with a modifier, a flag) across a style boundary since it works rune-by-rune. This package was promoted
+// out of a "myStyleRunes" helper that a couple of the demos in this repo had each grown their own copy of.
+package glossx
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/rivo/uniseg"
+)
+
+// StyleRunes highlights the runes at the given rune offsets into s with matched, rendering every other rune with
+// unmatched. It's a drop-in replacement for lipgloss.StyleRunes that also handles '\n' (emitting a bare, unstyled
+// newline so background colors don't bleed to the end of the line) and never splits a grapheme cluster across the
+// matched/unmatched boundary.
+func StyleRunes(s string, indices []int, matched, unmatched lipgloss.Style) string {
+	set := make(map[int]struct{}, len(indices))
+	for _, i := range indices {
+		set[i] = struct{}{}
+	}
+
+	return StyleByPredicate(s, func(i int, _ rune) bool {
+		_, ok := set[i]
+		return ok
+	}, matched, unmatched)
+}
+
+// StyleRuneRanges is StyleRunes for callers that already have [start, end) rune-offset pairs rather than a flat
+// list of indices, e.g. the match segments returned by github.com/sahilm/fuzzy.
+func StyleRuneRanges(s string, ranges [][2]int, matched, unmatched lipgloss.Style) string {
+	return StyleByPredicate(s, func(i int, _ rune) bool {
+		for _, r := range ranges {
+			if i >= r[0] && i < r[1] {
+				return true
+			}
+		}
+		return false
+	}, matched, unmatched)
+}
+
+// StyleByPredicate is what StyleRunes and StyleRuneRanges are built on. pred is asked about every rune in s (i is
+// the rune's offset, not its byte offset) to decide whether it counts as "matched". Runs of consecutive runes with
+// the same verdict are grouped into a single Render call, and grapheme clusters (as segmented by uniseg) are never
+// split across a group boundary: if any rune in a cluster matches, the whole cluster is rendered with matched.
+func StyleByPredicate(s string, pred func(i int, r rune) bool, matched, unmatched lipgloss.Style) string {
+	var (
+		out          strings.Builder
+		group        strings.Builder
+		grouped      bool
+		groupMatched bool
+	)
+
+	flush := func() {
+		if group.Len() == 0 {
+			return
+		}
+		style := unmatched
+		if groupMatched {
+			style = matched
+		}
+		out.WriteString(style.Render(group.String()))
+		group.Reset()
+	}
+
+	runeIdx := 0
+	gr := uniseg.NewGraphemes(s)
+	for gr.Next() {
+		cluster := gr.Runes()
+
+		if len(cluster) == 1 && cluster[0] == '\n' {
+			flush()
+			out.WriteString(lipgloss.NewStyle().Render("\n"))
+			grouped = false
+			runeIdx++
+			continue
+		}
+
+		clusterMatched := false
+		for _, r := range cluster {
+			if pred(runeIdx, r) {
+				clusterMatched = true
+			}
+			runeIdx++
+		}
+
+		if grouped && clusterMatched != groupMatched {
+			flush()
+		}
+		groupMatched = clusterMatched
+		grouped = true
+		group.WriteString(string(cluster))
+	}
+	flush()
+
+	return out.String()
+}
@@ -0,0 +1,78 @@
+package glossx
+
+import (
+	"os"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// TestMain forces a color profile before running the tests. Without this, termenv detects that tests run with no
+// attached terminal and downgrades every style to a no-op, which would make the matched/unmatched assertions below
+// pass regardless of whether StyleByPredicate actually applied the right style.
+func TestMain(m *testing.M) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	os.Exit(m.Run())
+}
+
+var (
+	testMatched   = lipgloss.NewStyle().Bold(true)
+	testUnmatched = lipgloss.NewStyle()
+)
+
+func TestStyleByPredicate_MultiLine(t *testing.T) {
+	// "a" is matched, everything else isn't, and there's a newline in the middle.
+	s := "ab\ncd"
+	got := StyleByPredicate(s, func(i int, _ rune) bool { return i == 0 }, testMatched, testUnmatched)
+
+	want := testMatched.Render("a") + testUnmatched.Render("b") + "\n" + testUnmatched.Render("cd")
+	if got != want {
+		t.Errorf("StyleByPredicate(%q) = %q, want %q", s, got, want)
+	}
+}
+
+func TestStyleByPredicate_CombiningMark(t *testing.T) {
+	// "e" + COMBINING ACUTE ACCENT (U+0065 U+0301, deliberately not the precomposed U+00E9) is two runes
+	// that form one grapheme cluster. Only the base rune's index is marked as matched, but the whole cluster
+	// must render as one unit rather than splitting the accent off into its own style.
+	eAcute := "é"
+	s := eAcute + "bc"
+	got := StyleByPredicate(s, func(i int, _ rune) bool { return i == 0 }, testMatched, testUnmatched)
+
+	want := testMatched.Render(eAcute) + testUnmatched.Render("bc")
+	if got != want {
+		t.Errorf("StyleByPredicate(%q) = %q, want %q", s, got, want)
+	}
+}
+
+func TestStyleByPredicate_EmojiModifierSequence(t *testing.T) {
+	// U+1F44D (THUMBS UP SIGN) + U+1F3FD (EMOJI MODIFIER FITZPATRICK TYPE-4) is one grapheme cluster rendered as a
+	// single emoji with a skin tone. Matching only the base rune's index must still style the whole cluster.
+	const thumbsUpMedium = "\U0001F44D\U0001F3FD"
+	s := "x" + thumbsUpMedium + "y"
+	matchIdx := 1 // the base rune of the emoji cluster
+
+	got := StyleByPredicate(s, func(i int, _ rune) bool { return i == matchIdx }, testMatched, testUnmatched)
+
+	want := testUnmatched.Render("x") + testMatched.Render(thumbsUpMedium) + testUnmatched.Render("y")
+	if got != want {
+		t.Errorf("StyleByPredicate(%q) = %q, want %q", s, got, want)
+	}
+}
+
+func TestStyleRunes(t *testing.T) {
+	got := StyleRunes("abc", []int{0, 2}, testMatched, testUnmatched)
+	want := testMatched.Render("a") + testUnmatched.Render("b") + testMatched.Render("c")
+	if got != want {
+		t.Errorf("StyleRunes = %q, want %q", got, want)
+	}
+}
+
+func TestStyleRuneRanges(t *testing.T) {
+	got := StyleRuneRanges("abcdef", [][2]int{{1, 3}, {5, 6}}, testMatched, testUnmatched)
+	want := testUnmatched.Render("a") + testMatched.Render("bc") + testUnmatched.Render("de") + testMatched.Render("f")
+	if got != want {
+		t.Errorf("StyleRuneRanges = %q, want %q", got, want)
+	}
+}
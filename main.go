@@ -0,0 +1,62 @@
+// The entrypoint for all the Bubble Tea demos in this repo. Instead of each demo being its own "go run" target, they
+// share this one program: a root menu (built with bubbles/list) lets you pick a demo, and a router model hosts
+// whichever demo is active, forwarding it Update/View calls. See pkg/screen for the Screen interface demos
+// implement, and menu.go and router.go for how the menu and router are wired together.
+//
+// This is the pattern several sibling TUIs use to share one binary across multiple tools, and it's a prerequisite
+// for adding future views (settings, help, a log inspector) without yet another top-level program.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dgroomes/bubble-tea-playground/pkg/filesummarizer"
+	"github.com/dgroomes/bubble-tea-playground/pkg/filesummarizeradhoc"
+	"github.com/dgroomes/bubble-tea-playground/pkg/gitfilesummarizer"
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+	"github.com/dgroomes/bubble-tea-playground/pkg/styling"
+)
+
+func main() {
+	// A combination of logs and the debugger have helped me debug issues in these programs. Bubble Tea has an
+	// official function to configure logging (https://github.com/charmbracelet/bubbletea/blob/3eb74e8d9dac487100b6d19ccc09b0c7820a6c7f/README.md?plain=1#L294)
+	// and it has print/logging functions (https://github.com/charmbracelet/bubbletea/blob/3eb74e8d9dac487100b6d19ccc09b0c7820a6c7f/tea.go#L746)
+	// but I couldn't get them to work. So, I'm just doing logging the direct way.
+	f, err := os.OpenFile(gitfilesummarizer.DebugLogFileName, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		log.Fatalf("error opening File: %v\n", err)
+	}
+	//goland:noinspection GoUnhandledErrorResult
+	defer f.Close()
+	log.SetOutput(f)
+
+	// The Git file summarizer's constructor needs a reference to the *tea.Program it runs under (its file watcher
+	// and size-fetcher worker pool call p.Send from their own goroutines), but that program doesn't exist until
+	// after the menu (and therefore the router model) is built. p is nil while the demos slice and menu are built
+	// below and assigned its real value before p.Run() ever processes a message, so by the time the user actually
+	// picks this demo from the menu, p is valid.
+	var p *tea.Program
+
+	// Each title here must match what the demo's own Screen.Title() returns: the menu shows the title before the
+	// Screen is ever constructed (constructing the Git project summarizer starts a file watcher, so it can't be
+	// built just to list it), so it's duplicated here rather than looked up.
+	demos := []demo{
+		{title: "Git Project Files Summarizer", new: func() screen.Screen { return gitfilesummarizer.NewScreen(p) }},
+		{title: "File Summarizer", new: func() screen.Screen { return filesummarizer.NewScreen() }},
+		{title: "File Summarizer (ad-hoc types)", new: func() screen.Screen { return filesummarizeradhoc.NewScreen() }},
+		{title: "Lip Gloss Styling", new: func() screen.Screen { return styling.NewScreen() }},
+	}
+
+	router := newRouterModel(newMenuScreen(demos))
+
+	p = tea.NewProgram(router, tea.WithAltScreen())
+
+	if _, err := p.Run(); err != nil {
+		fmt.Printf("Alas, there's been an error: %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	teaList "github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+)
+
+var menuAppStyle = lipgloss.NewStyle().Padding(1, 2)
+
+var menuTitleStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#FFFDF5")).
+	Background(lipgloss.Color("#25A065")).
+	Padding(0, 1)
+
+// demo registers one Screen with the root menu. title is shown in the menu; new constructs a fresh instance of the
+// Screen each time the demo is picked, so leaving a demo and re-entering it always starts over.
+type demo struct {
+	title string
+	new   func() screen.Screen
+}
+
+// demoItem adapts a demo to teaList.Item so it can be rendered by the default list delegate.
+type demoItem struct {
+	demo demo
+}
+
+func (d demoItem) Title() string       { return d.demo.title }
+func (d demoItem) Description() string { return "" }
+func (d demoItem) FilterValue() string { return d.demo.title }
+
+// menuScreen is the root Screen: a list of demos the user can launch. It never leaves itself, so its "q"/"ctrl+c"
+// both quit the whole program rather than popping (there's nothing to pop to).
+type menuScreen struct {
+	list teaList.Model
+}
+
+func newMenuScreen(demos []demo) screen.Screen {
+	items := make([]teaList.Item, 0, len(demos))
+	for _, d := range demos {
+		items = append(items, demoItem{demo: d})
+	}
+
+	l := teaList.New(items, teaList.NewDefaultDelegate(), 0, 0)
+	l.Title = "Bubble Tea Playground"
+	l.Styles.Title = menuTitleStyle
+
+	return menuScreen{list: l}
+}
+
+func (m menuScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (m menuScreen) Title() string {
+	return "Bubble Tea Playground"
+}
+
+func (m menuScreen) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+
+	case tea.WindowSizeMsg:
+		h, v := menuAppStyle.GetFrameSize()
+		m.list.SetSize(msg.Width-h, msg.Height-v)
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+
+		case "ctrl+c", "q":
+			return m, tea.Quit
+
+		case "enter":
+			item, ok := m.list.SelectedItem().(demoItem)
+			if !ok {
+				return m, nil
+			}
+			return m, func() tea.Msg {
+				return screen.PushMsg{Screen: item.demo.new()}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m menuScreen) View() string {
+	return menuAppStyle.Render(m.list.View())
+}
@@ -1,31 +1,27 @@
 // A demo program that showcases the Bubble Tea TUI. Please see the README for more information.
 //
 // This is adapted from the official tutorials: https://github.com/charmbracelet/bubbletea/tree/master/tutorials
-package main
+package filesummarizer
 
 import (
 	"fmt"
-	tea "github.com/charmbracelet/bubbletea"
 	"os"
 	"strings"
-)
 
-func main() {
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+)
 
-	initialModel := model{
+// NewScreen constructs the basic file summarizer demo.
+func NewScreen() screen.Screen {
+	return model{
 		fileNameOptions: nil,
 		cursor:          0,
 		selected:        make(map[int]struct{}),
 		executing:       false,
 		fileSummaries:   nil,
 	}
-
-	p := tea.NewProgram(initialModel)
-
-	if _, err := p.Run(); err != nil {
-		fmt.Printf("Alas, there's been an error: %v", err)
-		os.Exit(1)
-	}
 }
 
 // For when the files were listed.
@@ -77,6 +73,10 @@ func (m model) Init() tea.Cmd {
 	return listFiles
 }
 
+func (m model) Title() string {
+	return "File Summarizer"
+}
+
 // Summarize the given files. Return summaries for each file.
 func summarizeFiles(m model) ([]string, error) {
 	fileNameOptions := m.fileNameOptions
@@ -110,11 +110,11 @@ func summarizeFiles(m model) ([]string, error) {
 	return fileSummaries, nil
 }
 
-func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m model) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case error:
-		return m, tea.Quit
+		return m, screen.Pop
 
 	case fileListingMsg:
 		m.fileNameOptions = msg.fileNames
@@ -122,7 +122,6 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case summarizationMsg:
 		m.executing = false
 		m.fileSummaries = msg.fileSummaries
-		return m, tea.Quit
 
 	// Is it a key press?
 	case tea.KeyMsg:
@@ -130,10 +129,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Cool, what was the actual key pressed?
 		switch msg.String() {
 
-		// These keys should exit the program.
-		case "ctrl+c", "q":
+		case "ctrl+c":
 			return m, tea.Quit
 
+		// Leave the demo and go back to the menu.
+		case "q", "esc":
+			return m, screen.Pop
+
 		case "e":
 			m.executing = true
 			return m, func() tea.Msg {
@@ -188,7 +190,7 @@ func (m model) View() string {
 	}
 
 	if m.fileSummaries != nil {
-		return fmt.Sprintf("Complete! Below is a summary of the selected listFiles. It shows each file size, in bytes:\n%q\n", m.fileSummaries)
+		return fmt.Sprintf("Complete! Below is a summary of the selected listFiles. It shows each file size, in bytes:\n%q\n\nPress 'q' to go back to the menu.\n", m.fileSummaries)
 	}
 
 	var textBuilder strings.Builder
@@ -220,7 +222,7 @@ func (m model) View() string {
 		textBuilder.WriteString(fmt.Sprintf("%s [%s] %s\n", cursor, checked, choice))
 	}
 
-	textBuilder.WriteString("\nPress 'e' to execute the file summarization. Press 'q' to quit.\n")
+	textBuilder.WriteString("\nPress 'e' to execute the file summarization. Press 'q' to go back to the menu.\n")
 
 	// Send the text to the Bubble Tea framework. The framework will take care of rendering it to the terminal.
 	return textBuilder.String()
@@ -1,30 +1,29 @@
-package main
+// A demo program that showcases the Bubble Tea TUI. Please see the README for more information.
+//
+// This is adapted from the official tutorials: https://github.com/charmbracelet/bubbletea/tree/master/tutorials
+//
+// This is the original version of the file summarizer demo, kept around next to the rewritten one in
+// pkg/filesummarizer because it shows the "bad" ad-hoc message types the other demo replaced.
+package filesummarizeradhoc
 
 import (
 	"fmt"
 	"os"
-)
-import tea "github.com/charmbracelet/bubbletea"
 
-// A demo program that showcases the Bubble Tea TUI. Please see the README for more information.
-//
-// This is adapted from the official tutorials: https://github.com/charmbracelet/bubbletea/tree/master/tutorials
-func main() {
+	tea "github.com/charmbracelet/bubbletea"
 
-	initialModel := model{
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+)
+
+// NewScreen constructs the ad-hoc file summarizer demo.
+func NewScreen() screen.Screen {
+	return model{
 		choices:   nil,
 		cursor:    0,
 		selected:  make(map[int]struct{}),
 		executing: false,
 		summaries: nil,
 	}
-
-	p := tea.NewProgram(initialModel)
-
-	if err := p.Start(); err != nil {
-		fmt.Printf("Alas, there's been an error: %v", err)
-		os.Exit(1)
-	}
 }
 
 type model struct {
@@ -49,6 +48,10 @@ func (m model) Init() tea.Cmd {
 	return files
 }
 
+func (m model) Title() string {
+	return "File Summarizer (ad-hoc types)"
+}
+
 // Summarize the given files. Return summaries for each file.
 func summarizeFiles(m model) (summaries, error) {
 
@@ -80,11 +83,11 @@ func summarizeFiles(m model) (summaries, error) {
 
 type summaries []string
 
-func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+func (m model) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
 	switch msg := msg.(type) {
 
 	case error:
-		return m, tea.Quit
+		return m, screen.Pop
 
 	case []string:
 		// The initialization command has completed. We have the list of file names.
@@ -94,7 +97,6 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case summaries:
 		m.executing = false
 		m.summaries = msg
-		return m, tea.Quit
 
 	// Is it a key press?
 	case tea.KeyMsg:
@@ -102,10 +104,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Cool, what was the actual key pressed?
 		switch msg.String() {
 
-		// These keys should exit the program.
-		case "ctrl+c", "q":
+		case "ctrl+c":
 			return m, tea.Quit
 
+		// Leave the demo and go back to the menu.
+		case "q", "esc":
+			return m, screen.Pop
+
 		case "e":
 			m.executing = true
 			return m, func() tea.Msg {
@@ -160,7 +165,7 @@ func (m model) View() string {
 	}
 
 	if m.summaries != nil {
-		return fmt.Sprintf("Complete! Below is a summary of the selected files. It shows each file size, in bytes:\n%q\n", m.summaries)
+		return fmt.Sprintf("Complete! Below is a summary of the selected files. It shows each file size, in bytes:\n%q\n\nPress 'q' to go back to the menu.\n", m.summaries)
 	}
 
 	text := "What files should we summarize?\n\n"
@@ -191,7 +196,7 @@ func (m model) View() string {
 		text += fmt.Sprintf("%s [%s] %s\n", cursor, checked, choice)
 	}
 
-	text += "\nPress 'e' to execute the file summarization. Press 'q' to quit.\n"
+	text += "\nPress 'e' to execute the file summarization. Press 'q' to go back to the menu.\n"
 
 	// Send the text to the Bubble Tea framework. The framework will take care of rendering it to the terminal.
 	return text
@@ -0,0 +1,91 @@
+package gitfilesummarizer
+
+import (
+	teaList "github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+	"io"
+
+	"github.com/dgroomes/bubble-tea-playground/internal/glossx"
+)
+
+// fuzzyFilter is a teaList.FilterFunc backed by github.com/sahilm/fuzzy instead of the list package's built-in
+// substring filter. It gives VS-Code-style fuzzy path matching over the Git project files (e.g. "mdlgf" matches
+// "pkg/git-project-file-summarizer/main.go").
+func fuzzyFilter(term string, targets []string) []teaList.Rank {
+	matches := fuzzy.Find(term, targets)
+
+	ranks := make([]teaList.Rank, len(matches))
+	for i, match := range matches {
+		ranks[i] = teaList.Rank{
+			Index:          match.Index,
+			MatchedIndexes: match.MatchedIndexes,
+		}
+	}
+
+	return ranks
+}
+
+var (
+	normalTitleStyle   = lipgloss.NewStyle().PaddingLeft(2)
+	selectedTitleStyle = lipgloss.NewStyle().PaddingLeft(2).Foreground(lipgloss.Color("170"))
+	descriptionStyle   = lipgloss.NewStyle().PaddingLeft(2).Foreground(lipgloss.Color("241"))
+
+	// normalTitleStyleUnpadded/selectedTitleStyleUnpadded mirror normalTitleStyle/selectedTitleStyle without the
+	// left padding. They're the "unmatched" style passed to glossx.StyleRunes, which renders every unmatched run
+	// separately: a PaddingLeft baked into that style would be re-applied to every run instead of once at the start
+	// of the line, pushing spurious gaps into the middle of a fuzzy-matched title.
+	normalTitleStyleUnpadded   = lipgloss.NewStyle()
+	selectedTitleStyleUnpadded = lipgloss.NewStyle().Foreground(lipgloss.Color("170"))
+
+	titlePaddingStyle = lipgloss.NewStyle().PaddingLeft(2)
+
+	// highlightStyle is the styling applied to runes that took part in a fuzzy match. Same color/treatment as the
+	// highlight style in the Lip Gloss styling demo.
+	highlightStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#e322dd")).Underline(true)
+)
+
+// fuzzyDelegate is a teaList.ItemDelegate for File items that highlights the runes matched by 'fuzzyFilter',
+// instead of just rendering the plain title the way teaList.NewDefaultDelegate() does. It also renders a checkbox
+// for the "select multiple, summarize all" mode and, while spinnerView is non-empty, a spinner next to every item
+// that's currently fetching.
+type fuzzyDelegate struct {
+	spinnerView string
+}
+
+func (d fuzzyDelegate) Height() int                                { return 2 }
+func (d fuzzyDelegate) Spacing() int                               { return 1 }
+func (d fuzzyDelegate) Update(_ tea.Msg, _ *teaList.Model) tea.Cmd { return nil }
+
+func (d fuzzyDelegate) Render(w io.Writer, m teaList.Model, index int, item teaList.Item) {
+	f, ok := item.(File)
+	if !ok {
+		return
+	}
+
+	titleStyle := normalTitleStyle
+	titleStyleUnpadded := normalTitleStyleUnpadded
+	if index == m.Index() {
+		titleStyle = selectedTitleStyle
+		titleStyleUnpadded = selectedTitleStyleUnpadded
+	}
+
+	title := f.Title()
+	if matches := m.MatchesForItem(index); len(matches) > 0 {
+		title = titlePaddingStyle.Render(glossx.StyleRunes(title, matches, highlightStyle, titleStyleUnpadded))
+	} else {
+		title = titleStyle.Render(title)
+	}
+
+	checkbox := "[ ] "
+	if f.selected {
+		checkbox = "[x] "
+	}
+
+	if f.fetching && d.spinnerView != "" {
+		checkbox = d.spinnerView + " "
+	}
+
+	_, _ = io.WriteString(w, checkbox+title+"\n"+descriptionStyle.Render(f.Description()))
+}
@@ -3,7 +3,7 @@
 // minimize knowledge of the UI implementation details.
 //
 // A poignant effect of this design is that there should be zero references to the Bubble Tea API in this file.
-package main
+package gitfilesummarizer
 
 import (
 	"fmt"
@@ -16,9 +16,16 @@ import (
 	"time"
 )
 
+// DebugLogFileName is the log file main.go writes this program's own debug logging to, in the current working
+// directory. This repo's .gitignore doesn't cover it (it's a stray Rust project template, not tailored to this
+// repo), so it has to be excluded from the file list and the watcher explicitly: otherwise every log line written
+// triggers a Write event on debug.log, which gets logged, which triggers another Write event, forever.
+const DebugLogFileName = "debug.log"
+
 type File struct {
 	filePath string
 	fetching bool
+	selected bool  // Queued up to be summarized the next time the user presses "s".
 	size     int64 // -1 represents that the size has not yet been fetched.
 }
 
@@ -59,38 +66,42 @@ func prettyPrintBytes(bytes int64) string {
 	}
 }
 
-func listGitProjectFiles() ([]File, error) {
+// newGitignoreMatcher builds the gitignore.Matcher for the Git repository in the current working directory. It's
+// split out from 'listGitProjectFiles' so that the file watcher can re-use the exact same matcher instance instead
+// of re-reading the .gitignore files on every event.
+func newGitignoreMatcher() (gitignore.Matcher, error) {
 	currentWorkingDir, err := os.Getwd()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	repo, err := git.PlainOpen(currentWorkingDir)
-
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	worktree, err := repo.Worktree()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	// I think this finds the exclude patterns in the .gitignore file in the Git repository in this directory.
 	patterns, err := gitignore.ReadPatterns(worktree.Filesystem, nil)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	// I think 'worktree.Excludes' are the ignore patterns in maybe the home directory's .gitignore file. Not really
 	//sure.
 	patterns = append(patterns, worktree.Excludes...)
 
-	m := gitignore.NewMatcher(patterns)
+	return gitignore.NewMatcher(patterns), nil
+}
 
+func listGitProjectFiles(m gitignore.Matcher) ([]File, error) {
 	var files []File
 
-	err = filepath.WalkDir(".", func(path string, info os.DirEntry, err error) error {
+	err := filepath.WalkDir(".", func(path string, info os.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -120,7 +131,7 @@ func listGitProjectFiles() ([]File, error) {
 			return nil
 		}
 
-		if ignored {
+		if ignored || path == DebugLogFileName {
 			return nil
 		}
 
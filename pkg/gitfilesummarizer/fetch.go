@@ -0,0 +1,43 @@
+package gitfilesummarizer
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultWorkerCount is how many FetchSize operations sizeFetcher runs concurrently when the user triggers a
+// batch summarization with "s".
+const defaultWorkerCount = 4
+
+// sizeFetcher runs FetchSize operations concurrently through a small, bounded pool of worker goroutines, so that
+// summarizing many files doesn't block the UI or hammer the filesystem with unbounded concurrency. Each completed
+// fetch is pushed back into the Bubble Tea program as an afterFetch message.
+type sizeFetcher struct {
+	jobs chan File
+}
+
+// newSizeFetcher starts 'workers' goroutines pulling jobs off a buffered channel and sends each result back to 'p'.
+func newSizeFetcher(p *tea.Program, workers int) *sizeFetcher {
+	sf := &sizeFetcher{jobs: make(chan File, 64)}
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for f := range sf.jobs {
+				p.Send(afterFetch(f.FetchSize()))
+			}
+		}()
+	}
+
+	return sf
+}
+
+// submit queues a file to have its size fetched. It blocks if every worker is busy and the job buffer is full.
+func (sf *sizeFetcher) submit(f File) {
+	sf.jobs <- f
+}
+
+// stop closes the job channel, which lets every worker goroutine's 'range sf.jobs' return once it's done with
+// whatever it's currently fetching. Callers must call this exactly once, when the screen is torn down, or the
+// workers leak.
+func (sf *sizeFetcher) stop() {
+	close(sf.jobs)
+}
@@ -0,0 +1,112 @@
+package gitfilesummarizer
+
+import (
+	"bufio"
+	"bytes"
+	tea "github.com/charmbracelet/bubbletea"
+	"io"
+	"os"
+)
+
+// viewState selects which screen model.Update/model.View route to: the file list (stateList) or the single-file
+// pager opened with 'v' (statePager).
+type viewState int
+
+const (
+	stateList viewState = iota
+	statePager
+)
+
+const (
+	// binarySniffLen is how much of the start of the file we look at to decide whether it's binary.
+	binarySniffLen = 8 * 1024
+
+	// pagerChunkSize is how much we read from the file at a time, so we're not holding the whole thing in memory
+	// at once while we stream it into the viewport.
+	pagerChunkSize = 32 * 1024
+
+	// maxPagerBytes caps how much of a (non-binary) file we'll load into the viewport. Past this we truncate; the
+	// pager is for skimming a file, not a replacement for an editor.
+	maxPagerBytes = 2 * 1024 * 1024
+)
+
+// pagerContentMsg carries the result of loadFileForPager back to the model.
+type pagerContentMsg struct {
+	filePath string
+	content  string
+	binary   bool
+	size     int64
+}
+
+// loadFileForPager reads 'f' for display in the pager. It streams the file in fixed-size chunks rather than
+// reading it whole into memory, sniffs the first 8KiB for a NUL byte to detect binary content, and truncates
+// past maxPagerBytes.
+func loadFileForPager(f File) tea.Cmd {
+	return func() tea.Msg {
+		file, err := os.Open(f.filePath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+
+		fi, err := file.Stat()
+		if err != nil {
+			return err
+		}
+
+		reader := bufio.NewReader(file)
+		buf := make([]byte, pagerChunkSize)
+
+		head := make([]byte, 0, binarySniffLen)
+		var content bytes.Buffer
+		truncated := false
+
+		for {
+			n, readErr := reader.Read(buf)
+			if n > 0 {
+				if len(head) < binarySniffLen {
+					take := binarySniffLen - len(head)
+					if take > n {
+						take = n
+					}
+					head = append(head, buf[:take]...)
+
+					if bytes.IndexByte(head, 0) != -1 {
+						return pagerContentMsg{filePath: f.filePath, binary: true, size: fi.Size()}
+					}
+				}
+
+				if !truncated {
+					remaining := maxPagerBytes - content.Len()
+					if n >= remaining {
+						content.Write(buf[:remaining])
+						truncated = true
+					} else {
+						content.Write(buf[:n])
+					}
+				}
+			}
+
+			if readErr == io.EOF {
+				break
+			}
+			if readErr != nil {
+				return readErr
+			}
+
+			if truncated && len(head) >= binarySniffLen {
+				// We already know it's not binary and we've hit the size cap; no need to read the rest.
+				break
+			}
+		}
+
+		text := content.String()
+		if truncated {
+			text += "\n\n... (truncated; this file is larger than the pager will display)"
+		}
+
+		return pagerContentMsg{filePath: f.filePath, content: text, size: fi.Size()}
+	}
+}
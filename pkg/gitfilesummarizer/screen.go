@@ -0,0 +1,482 @@
+// A demo program that uses TUI components from the "Bubbles" component library (https://github.com/charmbracelet/bubbles)
+// like "list", "spinner", and "progress". This is an intermediate Bubble Tea example program that builds above the
+// basic example program in pkg/filesummarizer. This program is also adapted from the "Fancy List" official example
+// program: https://github.com/charmbracelet/bubbletea/tree/master/examples/list-fancy
+//
+// This program is a TUI (Text User Interface) and it presents a list of all files in the Git project. The list is
+// interactive. It can be real-time filtered by typing a glob search (fuzzy-matched, see delegate.go), and you can
+// select files to "summarize" one at a time with 'enter', or queue up several with 'space' and summarize them all
+// concurrently with 's'. The summarization will just fetch the file size. It's a toy example, but it should give you
+// a good idea of how to design a TUI program using Bubble Tea.
+package gitfilesummarizer
+
+import (
+	"fmt"
+	"github.com/charmbracelet/bubbles/key"
+	teaList "github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"log"
+	"time"
+
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+)
+
+var appStyle = lipgloss.NewStyle().Padding(1, 2)
+
+var titleStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#FFFDF5")).
+	Background(lipgloss.Color("#25A065")).
+	Padding(0, 1)
+
+// Title returns the plain file path: the fuzzy filter's MatchedIndexes (see fuzzyFilter) are positions into this
+// exact string, so the "selected" checkbox is prepended at render time in fuzzyDelegate instead of here.
+func (f File) Title() string       { return f.filePath }
+func (f File) FilterValue() string { return f.filePath }
+func (f File) Description() string {
+	if f.fetching {
+		return "Fetching..."
+	}
+	if f.size == -1 {
+		return "-"
+	}
+
+	return prettyPrintBytes(f.size)
+}
+
+type model struct {
+	state          viewState
+	teaListModel   teaList.Model
+	keys           key.Binding
+	matcher        gitignore.Matcher // The same matcher used to find the initial file list, re-used by the file watcher.
+	stopWatching   func()            // Tears down the file watcher goroutine. Nil until the watcher has started.
+	fetcher        *sizeFetcher      // Bounded worker pool backing the "s" (summarize selected) batch operation.
+	spinner        spinner.Model     // Animates next to every item that's currently fetching.
+	progressModel  progress.Model    // Reflects completed/total for the in-flight batch, if any.
+	totalToFetch   int               // How many files the current "s" batch dispatched. 0 when no batch is in-flight.
+	completedFetch int               // How many of 'totalToFetch' have reported back so far.
+	pagerViewport  viewport.Model    // Backs statePager; sized by resize() whenever the terminal window changes.
+	pagerFile      File              // The file currently open in the pager.
+}
+
+type foundFiles []File
+
+func (m *model) Init() tea.Cmd {
+	return func() tea.Msg {
+		log.Println("Go routine is executing to find Git project files ...")
+		files, err := listGitProjectFiles(m.matcher)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Artificially slow down the program to simulate a slow operation and get a visual effect in the TUI.
+		time.Sleep(750 * time.Millisecond)
+
+		log.Printf("Found %d files\n", len(files))
+
+		return foundFiles(files)
+	}
+}
+
+func (m *model) Title() string {
+	return "Git Project Files Summarizer"
+}
+
+type afterFetch File
+
+// Update routes to updateList or updatePager depending on which screen is active. See viewState.
+//
+// The background messages below (an in-flight FetchSize reporting back, a watcher event, a progress/spinner tick)
+// keep going to updateList even while the pager is open: they originate from goroutines that don't know or care
+// which screen is active, and dropping them would leave items stuck "Fetching..." forever or silently miss watcher
+// updates just because the user happened to press 'v'.
+func (m *model) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	switch msg.(type) {
+	case afterFetch, fileCreatedMsg, fileModifiedMsg, fileDeletedMsg, fileRenamedMsg, progress.FrameMsg, spinner.TickMsg:
+		return m.updateList(msg)
+	}
+
+	if m.state == statePager {
+		return m.updatePager(msg)
+	}
+	return m.updateList(msg)
+}
+
+func (m *model) updateList(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	var cmds []tea.Cmd
+	log.Printf("[updateList] msg=%v\n", msg)
+
+	switch msg := msg.(type) {
+
+	case foundFiles:
+		items := make([]teaList.Item, 0, len(msg))
+		for _, f := range msg {
+			items = append(items, f)
+		}
+		m.teaListModel.SetItems(items)
+		return m, nil
+
+	case afterFetch:
+		for i, item := range m.teaListModel.Items() {
+			f, ok := item.(File)
+			if !ok {
+				log.Fatalf("The 'Item' in the Bubbles list is not a 'File'. This is unexpected.\n")
+			}
+
+			if f.filePath == msg.filePath {
+				m.teaListModel.SetItem(i, File(msg))
+				break
+			}
+		}
+
+		if m.totalToFetch > 0 {
+			m.completedFetch++
+			cmds = append(cmds, m.progressModel.SetPercent(float64(m.completedFetch)/float64(m.totalToFetch)))
+			if m.completedFetch >= m.totalToFetch {
+				m.totalToFetch = 0
+				m.completedFetch = 0
+			}
+		}
+
+	case spinner.TickMsg:
+		if m.anyFetching() {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			m.teaListModel.SetDelegate(fuzzyDelegate{spinnerView: m.spinner.View()})
+			cmds = append(cmds, cmd)
+		}
+
+	case progress.FrameMsg:
+		newProgressModel, cmd := m.progressModel.Update(msg)
+		m.progressModel = newProgressModel.(progress.Model)
+		cmds = append(cmds, cmd)
+
+	case fileCreatedMsg:
+		m.teaListModel.InsertItem(len(m.teaListModel.Items()), File(msg))
+
+	case fileModifiedMsg:
+		if i, ok := m.findItemIndex(msg.filePath); ok {
+			// Invalidate the cached size so the next 'enter' on this item re-fetches it, but keep the prior
+			// selected/fetching state: an edit shouldn't silently drop a file that's queued or in-flight.
+			prior, ok := m.teaListModel.Items()[i].(File)
+			if !ok {
+				log.Fatalf("The 'Item' in the Bubbles list is not a 'File'. This is unexpected.\n")
+			}
+			m.teaListModel.SetItem(i, File{filePath: msg.filePath, size: -1, selected: prior.selected, fetching: prior.fetching})
+		}
+
+	case fileDeletedMsg:
+		if i, ok := m.findItemIndex(msg.filePath); ok {
+			m.teaListModel.RemoveItem(i)
+		}
+
+	case fileRenamedMsg:
+		if i, ok := m.findItemIndex(msg.oldFilePath); ok {
+			m.teaListModel.SetItem(i, msg.file)
+		} else {
+			m.teaListModel.InsertItem(len(m.teaListModel.Items()), msg.file)
+		}
+
+	case tea.WindowSizeMsg:
+		m.resize(msg.Width, msg.Height)
+
+	case tea.KeyMsg:
+
+		switch msg.String() {
+
+		case "v":
+			if len(m.teaListModel.Items()) == 0 {
+				return m, nil
+			}
+
+			selectedItem, ok := m.teaListModel.SelectedItem().(File)
+			if !ok {
+				log.Fatalf("The 'SelectedItem' in the Bubbles list is not a 'File'. This is unexpected.\n")
+			}
+
+			m.state = statePager
+			m.pagerFile = selectedItem
+			m.pagerViewport.SetContent("Loading...")
+			m.pagerViewport.GotoTop()
+			return m, loadFileForPager(selectedItem)
+
+		case "enter":
+			if len(m.teaListModel.Items()) == 0 {
+				log.Println("No files to summarize.")
+				return m, nil
+			}
+
+			selectedItem, ok := m.teaListModel.SelectedItem().(File)
+			if !ok {
+				log.Fatalf("The 'SelectedItem' in the Bubbles list is not a 'File'. This is unexpected.\n")
+			}
+
+			if selectedItem.size != -1 || selectedItem.fetching {
+				log.Println("Already fetched or fetching. Not fetching again.")
+				return m, nil
+			}
+
+			selectedItem.fetching = true
+			idx := m.teaListModel.Index()
+			m.teaListModel.SetItem(idx, selectedItem)
+			return m, tea.Batch(m.spinner.Tick, func() tea.Msg {
+				return afterFetch(selectedItem.FetchSize())
+			})
+
+		case " ":
+			if len(m.teaListModel.Items()) == 0 {
+				return m, nil
+			}
+
+			selectedItem, ok := m.teaListModel.SelectedItem().(File)
+			if !ok {
+				log.Fatalf("The 'SelectedItem' in the Bubbles list is not a 'File'. This is unexpected.\n")
+			}
+
+			if selectedItem.size != -1 || selectedItem.fetching {
+				log.Println("Already fetched or fetching. Can't queue for batch summarization.")
+				return m, nil
+			}
+
+			selectedItem.selected = !selectedItem.selected
+			m.teaListModel.SetItem(m.teaListModel.Index(), selectedItem)
+
+		case "s":
+			queued := 0
+			for i, item := range m.teaListModel.Items() {
+				f, ok := item.(File)
+				if !ok {
+					log.Fatalf("The 'Item' in the Bubbles list is not a 'File'. This is unexpected.\n")
+				}
+
+				if !f.selected {
+					continue
+				}
+
+				f.selected = false
+				f.fetching = true
+				m.teaListModel.SetItem(i, f)
+				m.fetcher.submit(f)
+				queued++
+			}
+
+			if queued == 0 {
+				log.Println("No files selected. Press 'space' to queue files up for batch summarization.")
+				return m, nil
+			}
+
+			m.totalToFetch = queued
+			m.completedFetch = 0
+			return m, m.spinner.Tick
+
+		case "ctrl+c":
+			m.teardown()
+			return m, tea.Quit
+
+		// Leave the demo and go back to the menu.
+		case "q":
+			m.teardown()
+			return m, screen.Pop
+		}
+	}
+
+	newListModel, cmd := m.teaListModel.Update(msg)
+	m.teaListModel = newListModel
+	log.Printf("[updateList] newListModel=%d\n", len(newListModel.Items()))
+	cmds = append(cmds, cmd)
+	return m, tea.Batch(cmds...)
+}
+
+// updatePager handles messages while statePager is active: paging keys, the file content/error arriving
+// asynchronously from loadFileForPager, and window resizes.
+func (m *model) updatePager(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	switch msg := msg.(type) {
+
+	case pagerContentMsg:
+		if msg.filePath != m.pagerFile.filePath {
+			// A stale result for a file we've since navigated away from. Ignore it.
+			return m, nil
+		}
+
+		m.pagerFile.size = msg.size
+		if msg.binary {
+			m.pagerViewport.SetContent(fmt.Sprintf("binary file, %s", prettyPrintBytes(msg.size)))
+		} else {
+			m.pagerViewport.SetContent(msg.content)
+		}
+		m.pagerViewport.GotoTop()
+		return m, nil
+
+	case error:
+		log.Printf("Failed to load %s for the pager: %v\n", m.pagerFile.filePath, msg)
+		m.pagerViewport.SetContent(fmt.Sprintf("Failed to load file: %v", msg))
+		return m, nil
+
+	case tea.WindowSizeMsg:
+		m.resize(msg.Width, msg.Height)
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+
+		case "esc", "q":
+			m.state = stateList
+			return m, nil
+
+		case "home":
+			m.pagerViewport.GotoTop()
+			return m, nil
+
+		case "end":
+			m.pagerViewport.GotoBottom()
+			return m, nil
+
+		case "ctrl+c":
+			m.teardown()
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	m.pagerViewport, cmd = m.pagerViewport.Update(msg)
+	return m, cmd
+}
+
+// resize re-fits both the list and the pager viewport to the terminal's dimensions. It's called from both
+// updateList and updatePager since the user can resize the terminal from either screen.
+func (m *model) resize(width, height int) {
+	h, v := appStyle.GetFrameSize()
+	m.teaListModel.SetSize(width-h, height-v)
+
+	titleBarHeight := lipgloss.Height(titleStyle.Render(" "))
+	m.pagerViewport.Width = width - h
+	m.pagerViewport.Height = height - v - titleBarHeight - 1 // -1 for the blank line between the title bar and the content.
+}
+
+// teardown stops the background goroutines this screen started (the file watcher and the size-fetcher worker
+// pool). Callers must call this once, on every path that leaves the screen (quitting the whole program or
+// popping back to the menu), or those goroutines leak for the rest of the process's life.
+func (m *model) teardown() {
+	if m.stopWatching != nil {
+		m.stopWatching()
+	}
+	if m.fetcher != nil {
+		m.fetcher.stop()
+	}
+}
+
+// findItemIndex returns the index of the list item whose File.filePath matches the given path.
+func (m *model) findItemIndex(filePath string) (int, bool) {
+	for i, item := range m.teaListModel.Items() {
+		f, ok := item.(File)
+		if !ok {
+			log.Fatalf("The 'Item' in the Bubbles list is not a 'File'. This is unexpected.\n")
+		}
+
+		if f.filePath == filePath {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// anyFetching reports whether at least one item is still waiting on its FetchSize to come back, which tells us
+// whether it's worth keeping the spinner ticking.
+func (m *model) anyFetching() bool {
+	for _, item := range m.teaListModel.Items() {
+		if f, ok := item.(File); ok && f.fetching {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *model) View() string {
+	if m.state == statePager {
+		return m.viewPager()
+	}
+	return m.viewList()
+}
+
+func (m *model) viewList() string {
+	view := m.teaListModel.View()
+	if m.totalToFetch > 0 {
+		view += "\n" + m.progressModel.View()
+	}
+	return appStyle.Render(view)
+}
+
+func (m *model) viewPager() string {
+	size := "..."
+	if m.pagerFile.size != -1 {
+		size = prettyPrintBytes(m.pagerFile.size)
+	}
+	title := fmt.Sprintf("%s (%s)", m.pagerFile.filePath, size)
+	header := titleStyle.Render(title)
+	return appStyle.Render(header + "\n\n" + m.pagerViewport.View())
+}
+
+// NewScreen builds the Git project files summarizer demo. p is the top-level tea.Program the router is about to run
+// this Screen under; the file watcher and the size-fetching worker pool both need it so their own goroutines can
+// push messages in via p.Send.
+func NewScreen(p *tea.Program) screen.Screen {
+	listKeyMap := key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "summarize selected"))
+	spaceKeyMap := key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "toggle queued for batch summarization"))
+	summarizeKeyMap := key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "summarize queued"))
+	viewKeyMap := key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "view file contents"))
+
+	// We used to use teaList.NewDefaultDelegate() here, but it doesn't give us a way to highlight the runes matched
+	// by the fuzzy filter below, so we render items ourselves with fuzzyDelegate.
+	sp := spinner.New(spinner.WithSpinner(spinner.Dot))
+	delegate := fuzzyDelegate{spinnerView: sp.View()}
+	teaListModel := teaList.New(make([]teaList.Item, 0), delegate, 0, 0)
+	teaListModel.Title = "Git Project Files Summarizer"
+	teaListModel.Styles.Title = titleStyle
+	teaListModel.Filter = fuzzyFilter
+	teaListModel.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{
+			listKeyMap,
+			spaceKeyMap,
+			summarizeKeyMap,
+			viewKeyMap,
+		}
+	}
+
+	matcher, err := newGitignoreMatcher()
+	if err != nil {
+		log.Fatalf("Failed to build the gitignore matcher: %v\n", err)
+	}
+
+	m := &model{
+		teaListModel:  teaListModel,
+		keys:          listKeyMap,
+		matcher:       matcher,
+		spinner:       sp,
+		progressModel: progress.New(progress.WithDefaultGradient()),
+		pagerViewport: viewport.New(0, 0),
+		fetcher:       newSizeFetcher(p, defaultWorkerCount),
+	}
+
+	stopWatching, err := watchGitProjectFiles(p, matcher)
+	if err != nil {
+		// The TUI is still useful without live updates, so we don't bail out here, we just log it.
+		log.Printf("Failed to start the file watcher, live updates are disabled: %v\n", err)
+	} else {
+		m.stopWatching = stopWatching
+	}
+
+	return m
+}
@@ -0,0 +1,197 @@
+package gitfilesummarizer
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// fileCreatedMsg is sent when a new, non-ignored file shows up in the working tree.
+type fileCreatedMsg File
+
+// fileModifiedMsg is sent when an existing file's contents change. The size is always -1: callers should treat this
+// the same as a freshly-discovered file whose size hasn't been fetched yet.
+type fileModifiedMsg File
+
+// fileDeletedMsg is sent when a file disappears from the working tree.
+type fileDeletedMsg struct {
+	filePath string
+}
+
+// fileRenamedMsg is sent when a file is renamed or moved. fsnotify reports a rename as a pair of separate
+// events (the old path going away, the new path showing up), so we coalesce that pair into a single message;
+// see the 'renameTimeout' handling below.
+type fileRenamedMsg struct {
+	oldFilePath string
+	file        File
+}
+
+// How long we wait, after seeing a Rename event for a path, for a matching Create event before giving up and
+// treating it as a plain deletion. fsnotify emits the pair back-to-back in practice, so this is generous.
+const renameCoalesceWindow = 100 * time.Millisecond
+
+// watchGitProjectFiles watches the Git project's working tree (every non-gitignored directory, per 'm') for file
+// changes and emits fileCreatedMsg/fileModifiedMsg/fileDeletedMsg/fileRenamedMsg into 'p' as they happen. The
+// returned stop function tears down the watcher goroutine; callers should call it once, when the program quits.
+func watchGitProjectFiles(p *tea.Program, m gitignore.Matcher) (stop func(), err error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	currentWorkingDir, err := os.Getwd()
+	if err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	if err := addWatches(watcher, m, "."); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		var pendingRenameOldPath string
+		var renameTimer *time.Timer
+		var renameTimerC <-chan time.Time
+
+		flushPendingRename := func() {
+			if pendingRenameOldPath != "" {
+				p.Send(fileDeletedMsg{filePath: pendingRenameOldPath})
+				pendingRenameOldPath = ""
+			}
+			renameTimerC = nil
+		}
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				relPath, relErr := filepath.Rel(currentWorkingDir, event.Name)
+				if relErr != nil {
+					relPath = event.Name
+				}
+
+				// DebugLogFileName isn't covered by .gitignore, so 'm' wouldn't filter it out on its own: every
+				// line this program logs would otherwise be a Write event on its own log file, which gets logged,
+				// which triggers another Write event, forever.
+				if relPath == DebugLogFileName {
+					continue
+				}
+
+				pathComponents := strings.Split(filepath.Clean(relPath), string(filepath.Separator))
+
+				switch {
+				case event.Op&fsnotify.Rename == fsnotify.Rename:
+					// Don't know yet if this is a plain delete or one half of a rename. Wait a beat for the
+					// matching Create event.
+					flushPendingRename()
+					pendingRenameOldPath = relPath
+					if renameTimer != nil {
+						renameTimer.Stop()
+					}
+					renameTimer = time.NewTimer(renameCoalesceWindow)
+					renameTimerC = renameTimer.C
+
+				case event.Op&fsnotify.Create == fsnotify.Create:
+					fi, statErr := os.Stat(event.Name)
+					if statErr != nil {
+						// The file may have already been removed again by the time we stat it. Nothing to do.
+						continue
+					}
+
+					if m.Match(pathComponents, fi.IsDir()) {
+						continue
+					}
+
+					if fi.IsDir() {
+						if err := addWatches(watcher, m, relPath); err != nil {
+							log.Printf("Failed to watch new directory %s: %v\n", relPath, err)
+						}
+						continue
+					}
+
+					newFile := File{filePath: relPath, size: -1}
+					if pendingRenameOldPath != "" {
+						if renameTimer != nil {
+							renameTimer.Stop()
+						}
+						renameTimerC = nil
+						p.Send(fileRenamedMsg{oldFilePath: pendingRenameOldPath, file: newFile})
+						pendingRenameOldPath = ""
+						continue
+					}
+
+					p.Send(fileCreatedMsg(newFile))
+
+				case event.Op&fsnotify.Write == fsnotify.Write:
+					if m.Match(pathComponents, false) {
+						continue
+					}
+					p.Send(fileModifiedMsg{filePath: relPath, size: -1})
+
+				case event.Op&fsnotify.Remove == fsnotify.Remove:
+					if m.Match(pathComponents, false) {
+						continue
+					}
+					p.Send(fileDeletedMsg{filePath: relPath})
+				}
+
+			case <-renameTimerC:
+				flushPendingRename()
+
+			case watchErr, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("Watcher error: %v\n", watchErr)
+
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	stop = func() {
+		close(done)
+		if err := watcher.Close(); err != nil {
+			log.Printf("Failed to close watcher: %v\n", err)
+		}
+	}
+
+	return stop, nil
+}
+
+// addWatches recursively adds a fsnotify watch for 'root' and every non-ignored subdirectory beneath it.
+func addWatches(watcher *fsnotify.Watcher, m gitignore.Matcher, root string) error {
+	return filepath.WalkDir(root, func(path string, info os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			return nil
+		}
+
+		if path == ".git" {
+			return filepath.SkipDir
+		}
+
+		pathComponents := strings.Split(filepath.Clean(path), string(filepath.Separator))
+		if m.Match(pathComponents, true) {
+			return filepath.SkipDir
+		}
+
+		return watcher.Add(path)
+	})
+}
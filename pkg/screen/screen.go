@@ -0,0 +1,32 @@
+// Package screen defines the small interface that lets the top-level router in main.go host several otherwise
+// unrelated Bubble Tea demos in one program, plus the messages demos use to ask the router to push/pop them.
+package screen
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// Screen is what a demo implements instead of wiring up its own tea.Program. It's the same shape as tea.Model
+// except Update returns a Screen instead of a tea.Model, so the router never needs a type assertion to keep
+// calling Update/View on whatever comes back.
+type Screen interface {
+	Init() tea.Cmd
+	Update(msg tea.Msg) (Screen, tea.Cmd)
+	View() string
+	Title() string
+}
+
+// PushMsg asks the router to put a new Screen on top of the stack and make it active. The root menu sends this
+// when the user picks a demo.
+type PushMsg struct {
+	Screen Screen
+}
+
+// PopMsg asks the router to leave the active Screen and return to whatever was active before it (or, if the
+// active Screen is the root menu, to quit the whole program). Demos send this from whatever key used to quit
+// their standalone program, e.g. "q".
+type PopMsg struct{}
+
+// Pop is the tea.Cmd a Screen returns from Update to leave itself. It's a drop-in replacement for the tea.Quit a
+// demo used when it was its own main package.
+func Pop() tea.Msg {
+	return PopMsg{}
+}
@@ -0,0 +1,117 @@
+// Ad-hoc Lip Gloss styling code, wired up as a Screen so it can be picked from the router's menu.
+package styling
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/dgroomes/bubble-tea-playground/internal/glossx"
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+)
+
+var txt = "Hello, World!"
+var multiLineTxt = "Hello,\nWorld!"
+
+var noStyle = lipgloss.NewStyle()
+
+var regularStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("#1693db")).
+	Foreground(lipgloss.Color("#ffffff"))
+
+var highlightStyle = lipgloss.NewStyle().
+	Background(lipgloss.Color("#e322dd")).
+	Foreground(lipgloss.Color("#ffffff")).
+	Underline(true)
+
+var paddingStyle = lipgloss.NewStyle().PaddingLeft(2)
+
+var marginStyle = lipgloss.NewStyle().MarginLeft(2)
+
+var leftBorderStyle = lipgloss.NewStyle().Border(lipgloss.NormalBorder(), false, false, false, true)
+
+// model is the Screen for this demo. Unlike the others, there's nothing to fetch or wait on: the whole point is to
+// eyeball a handful of Lip Gloss renders side-by-side, so View just builds that text once.
+type model struct{}
+
+// NewScreen constructs the Lip Gloss styling demo.
+func NewScreen() screen.Screen {
+	return model{}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (screen.Screen, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "q", "esc":
+			return m, screen.Pop
+		}
+	}
+	return m, nil
+}
+
+func (m model) Title() string {
+	return "Lip Gloss Styling"
+}
+
+func (m model) View() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Plain text:", txt)
+
+	regularStyledTxt := regularStyle.Render(txt)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Regular style:", regularStyledTxt)
+
+	highlightStyledTxt := highlightStyle.Render(txt)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Highlight style:", highlightStyledTxt)
+
+	runeOnlyHighlightStyledTxt := lipgloss.StyleRunes(txt, []int{1, 3, 5}, noStyle, highlightStyle)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Rune-only highlight style:", runeOnlyHighlightStyledTxt)
+
+	regularPlusRuneHighlightStyledTxt := lipgloss.StyleRunes(txt, []int{1, 3, 5}, regularStyle, highlightStyle)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Regular plus rune highlight style:", regularPlusRuneHighlightStyledTxt)
+
+	paddedTxt := paddingStyle.Render(txt)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Padded text:", paddedTxt)
+
+	paddedPlusRegularStyledTxt := paddingStyle.Inherit(regularStyle).Render(txt)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Padded plus regular style:", paddedPlusRegularStyledTxt)
+
+	// Interestingly, the left margin is also blue. I need to figure out how to combine styles and "renders" as I wish.
+	marginPlusRegularStyledTxt := marginStyle.Inherit(regularStyle).Render(txt)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Margin plus regular style:", marginPlusRegularStyledTxt)
+
+	// Regular-styled text and then placed in a margin style. Update: yep worked.
+	fmt.Fprintf(&b, "Experiment:\n%s\n\n", marginStyle.Render(regularStyle.Render(txt)))
+
+	// Let's try multi-line. Regular styled.
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Multi-line, regular style:", regularStyle.Render(multiLineTxt))
+
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Multi-line, regular inherits margin, then render:", marginStyle.Inherit(regularStyle).Render(multiLineTxt))
+
+	// Render regular style, then place in a margin style.
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Multi-line, regular style, then margin style:", marginStyle.Render(regularStyle.Render(multiLineTxt)))
+
+	// Multi-line, regular style, plus rune highlighting
+	//
+	// There is an unexpected problem. There is space to the right of the first line that's styled with a blue
+	// background. Is this the semantic behavior, a defect, or I'm just using it wrong?
+	multiLineRegularStylePlusRuneHighlighting := lipgloss.StyleRunes(multiLineTxt, []int{1, 3, 5}, highlightStyle, regularStyle)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Multi-line, rune highlighting:", multiLineRegularStylePlusRuneHighlighting)
+
+	// Put it altogether. glossx.StyleRunes (which handles multi-line text correctly, unlike lipgloss.StyleRunes),
+	// plus padding/border.
+	multiLineRegularStylePlusMyRuneHighlighting := glossx.StyleRunes(multiLineTxt, []int{1, 3, 5}, highlightStyle, regularStyle)
+	fmt.Fprintf(&b, "%s\n%s\n\n", "Multi-line, (glossx) rune highlighting, then padding style and left-border style:", paddingStyle.Inherit(leftBorderStyle).Render(multiLineRegularStylePlusMyRuneHighlighting))
+
+	b.WriteString("\nPress 'q' to go back to the menu.\n")
+
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dgroomes/bubble-tea-playground/pkg/screen"
+)
+
+// routerModel is the single tea.Model the program actually runs. It owns a stack of screen.Screen: the root menu
+// at index 0, plus whichever demo the user has pushed on top of it. Update/View are always forwarded to the top of
+// the stack.
+type routerModel struct {
+	stack  []screen.Screen
+	width  int
+	height int
+}
+
+func newRouterModel(menu screen.Screen) routerModel {
+	return routerModel{stack: []screen.Screen{menu}}
+}
+
+func (r routerModel) active() screen.Screen {
+	return r.stack[len(r.stack)-1]
+}
+
+func (r routerModel) Init() tea.Cmd {
+	return r.active().Init()
+}
+
+func (r routerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+
+	case tea.WindowSizeMsg:
+		r.width, r.height = msg.Width, msg.Height
+
+	case screen.PushMsg:
+		r.stack = append(r.stack, msg.Screen)
+		// The new screen hasn't seen a WindowSizeMsg yet, so it doesn't know how big to render. Give it one
+		// immediately instead of waiting for the user to resize the terminal.
+		sized, cmd := msg.Screen.Update(tea.WindowSizeMsg{Width: r.width, Height: r.height})
+		r.stack[len(r.stack)-1] = sized
+		return r, tea.Batch(sized.Init(), cmd)
+
+	case screen.PopMsg:
+		if len(r.stack) > 1 {
+			r.stack = r.stack[:len(r.stack)-1]
+			return r, nil
+		}
+		// We're already at the root menu; there's nowhere to pop to, so this means "quit".
+		return r, tea.Quit
+	}
+
+	active, cmd := r.active().Update(msg)
+	r.stack[len(r.stack)-1] = active
+	return r, cmd
+}
+
+func (r routerModel) View() string {
+	return r.active().View()
+}